@@ -25,7 +25,9 @@ import (
 	"github.com/loft-sh/devpod/pkg/dockercredentials"
 	"github.com/loft-sh/devpod/pkg/envfile"
 	"github.com/loft-sh/devpod/pkg/extract"
+	"github.com/loft-sh/devpod/pkg/ide/emacs"
 	"github.com/loft-sh/devpod/pkg/ide/fleet"
+	"github.com/loft-sh/devpod/pkg/ide/ideparse"
 	"github.com/loft-sh/devpod/pkg/ide/jetbrains"
 	"github.com/loft-sh/devpod/pkg/ide/jupyter"
 	"github.com/loft-sh/devpod/pkg/ide/openvscode"
@@ -358,38 +360,65 @@ func configureDockerCredentials(
 }
 
 func (cmd *SetupContainerCmd) installIDE(setupInfo *config.Result, ide *provider2.WorkspaceIDEConfig, log log.Logger) error {
+	ideOptions, err := mergeIDEOptions(ide, log)
+	if err != nil {
+		return err
+	}
+
 	switch ide.Name {
 	case string(config2.IDENone):
 		return nil
 	case string(config2.IDEVSCode):
-		return cmd.setupVSCode(setupInfo, ide.Options, log)
+		return cmd.setupVSCode(setupInfo, ideOptions, log)
 	case string(config2.IDEOpenVSCode):
-		return cmd.setupOpenVSCode(setupInfo, ide.Options, log)
+		return cmd.setupOpenVSCode(setupInfo, ideOptions, log)
 	case string(config2.IDEGoland):
-		return jetbrains.NewGolandServer(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewGolandServer(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDEPyCharm):
-		return jetbrains.NewPyCharmServer(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewPyCharmServer(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDEPhpStorm):
-		return jetbrains.NewPhpStorm(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewPhpStorm(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDEIntellij):
-		return jetbrains.NewIntellij(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewIntellij(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDECLion):
-		return jetbrains.NewCLionServer(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewCLionServer(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDERider):
-		return jetbrains.NewRiderServer(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewRiderServer(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDERubyMine):
-		return jetbrains.NewRubyMineServer(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewRubyMineServer(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDEWebStorm):
-		return jetbrains.NewWebStormServer(config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jetbrains.NewWebStormServer(config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	case string(config2.IDEFleet):
-		return fleet.NewFleetServer(config.GetRemoteUser(setupInfo), ide.Options, log).Install(setupInfo.SubstitutionContext.ContainerWorkspaceFolder)
+		return fleet.NewFleetServer(config.GetRemoteUser(setupInfo), ideOptions, log).Install(setupInfo.SubstitutionContext.ContainerWorkspaceFolder)
 	case string(config2.IDEJupyterNotebook):
-		return jupyter.NewJupyterNotebookServer(setupInfo.SubstitutionContext.ContainerWorkspaceFolder, config.GetRemoteUser(setupInfo), ide.Options, log).Install()
+		return jupyter.NewJupyterNotebookServer(setupInfo.SubstitutionContext.ContainerWorkspaceFolder, config.GetRemoteUser(setupInfo), ideOptions, log).Install()
+	case string(config2.IDEEmacs):
+		return emacs.NewEmacsServer(setupInfo.SubstitutionContext.ContainerWorkspaceFolder, config.GetRemoteUser(setupInfo), ideOptions, log).Install()
 	}
 
 	return nil
 }
 
+// mergeIDEOptions fills in the defaults of the ide options and warns about
+// options the ide doesn't know, which are dropped
+func mergeIDEOptions(ide *provider2.WorkspaceIDEConfig, log log.Logger) (map[string]config2.OptionValue, error) {
+	options, err := ideparse.GetIDEOptions(ide.Name)
+	if err != nil {
+		// unknown ides are not installed, so there is nothing to merge
+		return ide.Options, nil
+	}
+
+	merged, unknownKeys, err := options.Merge(ide.Options)
+	for _, key := range unknownKeys {
+		log.Warnf("Ignoring unknown option %s for ide %s", key, ide.Name)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "merge options of ide %s", ide.Name)
+	}
+
+	return merged, nil
+}
+
 func (cmd *SetupContainerCmd) setupVSCode(setupInfo *config.Result, ideOptions map[string]config2.OptionValue, log log.Logger) error {
 	log.Debugf("Setup vscode...")
 	vsCodeConfiguration := config.GetVSCodeConfiguration(setupInfo.MergedConfig)
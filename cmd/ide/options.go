@@ -39,7 +39,7 @@ func NewOptionsCmd(flags *flags.GlobalFlags) *cobra.Command {
 		},
 	}
 
-	optionsCmd.Flags().StringVar(&cmd.Output, "output", "plain", "The output format to use. Can be json or plain")
+	optionsCmd.Flags().StringVar(&cmd.Output, "output", "plain", "The output format to use. Can be json, json-schema or plain")
 	return optionsCmd
 }
 
@@ -97,8 +97,14 @@ func (cmd *OptionsCmd) Run(ctx context.Context, ide string) error {
 			return err
 		}
 		fmt.Print(string(out))
+	} else if cmd.Output == "json-schema" {
+		out, err := ideOptions.SchemaJSON()
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(out))
 	} else {
-		return fmt.Errorf("unexpected output format, choose either json or plain. Got %s", cmd.Output)
+		return fmt.Errorf("unexpected output format, choose either json, json-schema or plain. Got %s", cmd.Output)
 	}
 
 	return nil
@@ -245,6 +245,8 @@ func (cmd *UpCmd) Run(
 				ideConfig.Options,
 				log,
 			)
+		case string(config.IDEEmacs):
+			log.Infof("Run 'ssh %s.devpod -t emacsclient -t' to connect to the emacs daemon", client.Workspace())
 		}
 	}
 
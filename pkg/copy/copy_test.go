@@ -0,0 +1,34 @@
+package copy
+
+import (
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func BenchmarkFile(b *testing.B) {
+	// roughly the size of an ide binary
+	payload := make([]byte, 10*1024*1024)
+	_, err := rand.Read(payload)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	dir := b.TempDir()
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	err = os.WriteFile(src, payload, 0666)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err = File(src, dst, 0666)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
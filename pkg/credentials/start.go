@@ -64,7 +64,7 @@ func PingURL(ctx context.Context, url string) error {
 		return err
 	}
 
-	resp, err := devpodhttp.GetHTTPClient().Do(req)
+	resp, err := devpodhttp.GetHTTPClientNoRetry().Do(req)
 	if err != nil {
 		return err
 	}
@@ -9,12 +9,29 @@ import (
 var httpClient *http.Client
 var httpClientOnce sync.Once
 
+var httpClientNoRetry *http.Client
+var httpClientNoRetryOnce sync.Once
+
 func GetHTTPClient() *http.Client {
 	httpClientOnce.Do(func() {
-		customTransport := http.DefaultTransport.(*http.Transport).Clone()
-		customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-		httpClient = &http.Client{Transport: customTransport}
+		httpClient = &http.Client{Transport: NewRetryTransport(newTransport())}
 	})
 
 	return httpClient
 }
+
+// GetHTTPClientNoRetry returns a client that does not retry failed requests.
+// Use it for readiness probes that do their own polling.
+func GetHTTPClientNoRetry() *http.Client {
+	httpClientNoRetryOnce.Do(func() {
+		httpClientNoRetry = &http.Client{Transport: newTransport()}
+	})
+
+	return httpClientNoRetry
+}
+
+func newTransport() *http.Transport {
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	return customTransport
+}
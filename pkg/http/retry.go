@@ -0,0 +1,125 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultMaxRetries     = 3
+	DefaultInitialBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff     = 5 * time.Second
+)
+
+// RetryTransport wraps a http.RoundTripper and retries idempotent requests
+// that failed with a 5xx status code or a transient network error
+type RetryTransport struct {
+	// Transport is the underlying round tripper. Defaults to http.DefaultTransport
+	Transport http.RoundTripper
+
+	// MaxRetries is the maximum number of retries after the first attempt
+	MaxRetries int
+
+	// Backoff returns how long to wait before the given retry, starting at 1.
+	// Defaults to an exponential backoff
+	Backoff func(retry int) time.Duration
+}
+
+func NewRetryTransport(transport http.RoundTripper) *RetryTransport {
+	return &RetryTransport{
+		Transport:  transport,
+		MaxRetries: DefaultMaxRetries,
+		Backoff:    ExponentialBackoff(DefaultInitialBackoff, DefaultMaxBackoff),
+	}
+}
+
+// ExponentialBackoff doubles the wait time for every retry, starting at initial
+// and never exceeding maxBackoff
+func ExponentialBackoff(initial, maxBackoff time.Duration) func(retry int) time.Duration {
+	return func(retry int) time.Duration {
+		backoff := initial
+		for i := 1; i < retry && backoff < maxBackoff; i++ {
+			backoff *= 2
+		}
+		if backoff > maxBackoff {
+			return maxBackoff
+		}
+
+		return backoff
+	}
+}
+
+func (r *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if !isRetryable(req) {
+		return transport.RoundTrip(req)
+	}
+
+	for retry := 0; ; retry++ {
+		attemptReq := req
+		if retry > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := transport.RoundTrip(attemptReq)
+		if retry >= r.MaxRetries || req.Context().Err() != nil || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		// drain and close the body so the connection can be reused
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		backoff := DefaultInitialBackoff
+		if r.Backoff != nil {
+			backoff = r.Backoff(retry + 1)
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		return false
+	}
+
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return isTransientError(err)
+	}
+
+	return resp.StatusCode >= 500
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,143 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Method           string
+		FailingResponses int
+		MaxRetries       int
+
+		ExpectedStatus   int
+		ExpectedAttempts int
+	}{
+		{
+			Name:             "success after retries",
+			Method:           http.MethodGet,
+			FailingResponses: 2,
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "retries exhausted",
+			Method:           http.MethodGet,
+			FailingResponses: 5,
+			MaxRetries:       2,
+			ExpectedStatus:   http.StatusServiceUnavailable,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "post is not retried",
+			Method:           http.MethodPost,
+			FailingResponses: 2,
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusServiceUnavailable,
+			ExpectedAttempts: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts <= testCase.FailingResponses {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &RetryTransport{
+				MaxRetries: testCase.MaxRetries,
+				Backoff:    func(int) time.Duration { return 0 },
+			}}
+			req, err := http.NewRequest(testCase.Method, server.URL, strings.NewReader(""))
+			assert.NilError(t, err)
+
+			resp, err := client.Do(req)
+			assert.NilError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, resp.StatusCode, testCase.ExpectedStatus)
+			assert.Equal(t, attempts, testCase.ExpectedAttempts)
+		})
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(100*time.Millisecond, time.Second)
+	assert.Equal(t, backoff(1), 100*time.Millisecond)
+	assert.Equal(t, backoff(2), 200*time.Millisecond)
+	assert.Equal(t, backoff(4), 800*time.Millisecond)
+	assert.Equal(t, backoff(5), time.Second)
+}
+
+func TestRetryTransportTransientError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= 2 {
+			// close the connection without sending a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			assert.NilError(t, err)
+			_ = conn.Close()
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &RetryTransport{
+		MaxRetries: 3,
+		Backoff:    func(int) time.Duration { return 0 },
+	}}
+	resp, err := client.Get(server.URL)
+	assert.NilError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+	assert.Equal(t, attempts, 3)
+}
+
+func TestRetryTransportContextCancelledDuringBackoff(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &http.Client{Transport: &RetryTransport{
+		MaxRetries: 3,
+		Backoff: func(int) time.Duration {
+			cancel()
+			return time.Hour
+		},
+	}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	assert.NilError(t, err)
+
+	start := time.Now()
+	_, err = client.Do(req)
+	assert.Assert(t, errors.Is(err, context.Canceled), "expected context canceled, got %v", err)
+	assert.Equal(t, attempts, 1)
+	assert.Assert(t, time.Since(start) < time.Minute)
+}
@@ -0,0 +1,247 @@
+package emacs
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/loft-sh/devpod/pkg/command"
+	"github.com/loft-sh/devpod/pkg/config"
+	"github.com/loft-sh/devpod/pkg/ide"
+	"github.com/loft-sh/devpod/pkg/single"
+	"github.com/loft-sh/log"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	DoomEmacsOption = "DOOM_EMACS"
+)
+
+var Options = ide.Options{
+	DoomEmacsOption: {
+		Name:        DoomEmacsOption,
+		Description: "If DevPod should install and bootstrap Doom Emacs into ~/.config/emacs",
+		Type:        ide.OptionTypeBool,
+		Default:     "false",
+		Enum: []string{
+			"true",
+			"false",
+		},
+	},
+}
+
+const PidFile = "emacs.pid"
+
+type ServerStatus string
+
+const (
+	ServerStatusRunning ServerStatus = "Running"
+	ServerStatusStopped ServerStatus = "Stopped"
+)
+
+const DoomEmacsRepository = "https://github.com/doomemacs/doomemacs"
+
+func NewEmacsServer(workspaceFolder string, userName string, values map[string]config.OptionValue, log log.Logger) *EmacsServer {
+	return &EmacsServer{
+		values:          values,
+		workspaceFolder: workspaceFolder,
+		userName:        userName,
+		log:             log,
+	}
+}
+
+type EmacsServer struct {
+	values          map[string]config.OptionValue
+	workspaceFolder string
+	userName        string
+	log             log.Logger
+}
+
+func (o *EmacsServer) Install() error {
+	err := o.installEmacs()
+	if err != nil {
+		return err
+	}
+
+	doomEmacs, err := Options.GetBoolValue(o.values, DoomEmacsOption)
+	if err != nil {
+		return err
+	} else if doomEmacs {
+		err = o.installDoomEmacs()
+		if err != nil {
+			return err
+		}
+	}
+
+	return o.Start()
+}
+
+func (o *EmacsServer) installEmacs() error {
+	if command.ExistsForUser("emacs", o.userName) {
+		return nil
+	}
+
+	// check which package manager exists
+	runCommand := ""
+	if command.Exists("apt-get") {
+		runCommand = "apt-get update && DEBIAN_FRONTEND=noninteractive apt-get install -y emacs-nox"
+	} else if command.Exists("dnf") {
+		runCommand = "dnf install -y emacs-nox"
+	} else if command.Exists("apk") {
+		runCommand = "apk update && apk add emacs-nox"
+	} else {
+		return fmt.Errorf("seems like neither apt-get, dnf nor apk exists, please make sure to install emacs in the container")
+	}
+
+	// install
+	o.log.Infof("Installing emacs...")
+	out, err := exec.Command("sh", "-c", runCommand).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error installing emacs: %w", command.WrapCommandError(out, err))
+	}
+
+	o.log.Info("Successfully installed emacs")
+	return nil
+}
+
+func (o *EmacsServer) installDoomEmacs() error {
+	var err error
+	homeFolder := ""
+	if o.userName != "" {
+		homeFolder, err = command.GetHome(o.userName)
+	} else {
+		homeFolder, err = homedir.Dir()
+	}
+	if err != nil {
+		return err
+	}
+
+	// is installed
+	emacsFolder := filepath.Join(homeFolder, ".config", "emacs")
+	doomBinary := filepath.Join(emacsFolder, "bin", "doom")
+	if command.ExistsForUser(doomBinary, o.userName) {
+		return nil
+	} else if !command.Exists("git") {
+		return fmt.Errorf("seems like git does not exist, please make sure to install git to use doom emacs")
+	}
+
+	// clone and bootstrap doom
+	runCommand := fmt.Sprintf("git clone --depth 1 '%s' '%s' && '%s' install --force", DoomEmacsRepository, emacsFolder, doomBinary)
+	args := []string{}
+	if o.userName != "" {
+		args = append(args, "su", o.userName, "-l", "-c", runCommand)
+	} else {
+		args = append(args, "sh", "-l", "-c", runCommand)
+	}
+
+	// install
+	o.log.Infof("Installing doom emacs...")
+	out, err := exec.Command(args[0], args[1:]...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error installing doom emacs: %w", command.WrapCommandError(out, err))
+	}
+
+	o.log.Info("Successfully installed doom emacs")
+	return nil
+}
+
+func (o *EmacsServer) Start() error {
+	return single.Single(PidFile, func() (*exec.Cmd, error) {
+		o.log.Infof("Starting emacs daemon in background...")
+
+		// the pid written by single belongs to the su / sh wrapper. We use
+		// --fg-daemon, so that wrapper lives as long as the daemon does,
+		// while --daemon would fork and let the wrapper exit immediately.
+		// The wrapper leads its own process group, so Stop can kill the
+		// daemon together with it
+		runCommand := "emacs --fg-daemon"
+		args := []string{}
+		if o.userName != "" {
+			args = append(args, "su", o.userName, "-l", "-c", runCommand)
+		} else {
+			args = append(args, "sh", "-l", "-c", runCommand)
+		}
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = o.workspaceFolder
+		setProcessGroup(cmd)
+		return cmd, nil
+	})
+}
+
+func (o *EmacsServer) Status() (ServerStatus, error) {
+	pid, err := readPid()
+	if err != nil {
+		return "", err
+	} else if pid == "" {
+		return ServerStatusStopped, nil
+	}
+
+	isRunning, err := command.IsRunning(pid)
+	if err != nil || !isRunning {
+		return ServerStatusStopped, nil
+	}
+
+	return ServerStatusRunning, nil
+}
+
+func (o *EmacsServer) Stop() error {
+	status, err := o.Status()
+	if err != nil {
+		return err
+	} else if status == ServerStatusStopped {
+		return removePid()
+	}
+
+	// ask the daemon to shut down, which also ends the wrapper process
+	o.log.Infof("Stopping emacs daemon...")
+	runCommand := "emacsclient -e '(kill-emacs)'"
+	args := []string{}
+	if o.userName != "" {
+		args = append(args, "su", o.userName, "-l", "-c", runCommand)
+	} else {
+		args = append(args, "sh", "-l", "-c", runCommand)
+	}
+	out, err := exec.Command(args[0], args[1:]...).CombinedOutput()
+	if err != nil {
+		o.log.Debugf("Error stopping emacs daemon through emacsclient: %v", command.WrapCommandError(out, err))
+
+		// killing only the wrapper would orphan the daemon, so we kill the
+		// whole group and keep the pid file if anything of it survives
+		pid, err := readPid()
+		if err != nil {
+			return err
+		} else if pid != "" {
+			err = killProcessGroup(pid)
+			if err != nil {
+				return fmt.Errorf("error stopping emacs daemon: %w", err)
+			}
+		}
+	}
+
+	o.log.Info("Successfully stopped emacs daemon")
+	return removePid()
+}
+
+func readPid() (string, error) {
+	pid, err := os.ReadFile(single.PidFile(PidFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return strings.TrimSpace(string(pid)), nil
+}
+
+func removePid() error {
+	err := os.Remove(single.PidFile(PidFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+//go:build linux || darwin || unix
+
+package emacs
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// setProcessGroup starts the command in its own process group, so the
+// wrapper and the emacs daemon below it can be stopped together
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup stops the process group led by pid and only returns
+// without an error once no process of the group is left
+func killProcessGroup(pid string) error {
+	parsedPid, err := strconv.Atoi(pid)
+	if err != nil {
+		return err
+	}
+
+	for _, signal := range []syscall.Signal{syscall.SIGTERM, syscall.SIGKILL} {
+		_ = syscall.Kill(-parsedPid, signal)
+		for i := 0; i < 20; i++ {
+			if syscall.Kill(-parsedPid, 0) == syscall.ESRCH && syscall.Kill(parsedPid, 0) == syscall.ESRCH {
+				return nil
+			}
+
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	return fmt.Errorf("process group %d is still running", parsedPid)
+}
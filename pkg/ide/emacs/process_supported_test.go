@@ -0,0 +1,39 @@
+//go:build linux || darwin || unix
+
+package emacs
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestKillProcessGroup(t *testing.T) {
+	// the wrapper prints the pid of its child, like su does with emacs
+	cmd := exec.Command("sh", "-c", "sleep 60 & echo $!; wait")
+	setProcessGroup(cmd)
+	stdout, err := cmd.StdoutPipe()
+	assert.NilError(t, err)
+	assert.NilError(t, cmd.Start())
+	go func() {
+		_ = cmd.Wait()
+	}()
+
+	buf := make([]byte, 32)
+	n, err := stdout.Read(buf)
+	assert.NilError(t, err)
+	childPid, err := strconv.Atoi(strings.TrimSpace(string(buf[:n])))
+	assert.NilError(t, err)
+
+	err = killProcessGroup(strconv.Itoa(cmd.Process.Pid))
+	assert.NilError(t, err)
+
+	process, err := os.FindProcess(childPid)
+	assert.NilError(t, err)
+	assert.Assert(t, process.Signal(syscall.Signal(0)) != nil, "expected child of the wrapper to be stopped")
+}
@@ -0,0 +1,14 @@
+//go:build windows
+
+package emacs
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+func killProcessGroup(pid string) error {
+	return fmt.Errorf("stopping process %s is unsupported", pid)
+}
@@ -3,12 +3,12 @@ package ideparse
 import (
 	"fmt"
 	"reflect"
-	"regexp"
 	"strings"
 
 	"github.com/loft-sh/devpod/pkg/command"
 	"github.com/loft-sh/devpod/pkg/config"
 	"github.com/loft-sh/devpod/pkg/ide"
+	"github.com/loft-sh/devpod/pkg/ide/emacs"
 	"github.com/loft-sh/devpod/pkg/ide/fleet"
 	"github.com/loft-sh/devpod/pkg/ide/jetbrains"
 	"github.com/loft-sh/devpod/pkg/ide/jupyter"
@@ -116,6 +116,12 @@ var AllowedIDEs = []AllowedIDE{
 		IconDark:     "https://devpod.sh/assets/jupyter_dark.svg",
 		Experimental: true,
 	},
+	{
+		Name:         config.IDEEmacs,
+		DisplayName:  "Emacs",
+		Options:      emacs.Options,
+		Experimental: true,
+	},
 }
 
 func RefreshIDEOptions(devPodConfig *config.Config, workspace *provider.Workspace, ide string, options []string) (*provider.Workspace, error) {
@@ -224,32 +230,9 @@ func ParseOptions(options []string, ideOptions ide.Options) (map[string]config.O
 			return nil, fmt.Errorf("invalid option '%s', allowed options are: %v", key, allowedOptions)
 		}
 
-		if ideOption.ValidationPattern != "" {
-			matcher, err := regexp.Compile(ideOption.ValidationPattern)
-			if err != nil {
-				return nil, err
-			}
-
-			if !matcher.MatchString(value) {
-				if ideOption.ValidationMessage != "" {
-					return nil, fmt.Errorf(ideOption.ValidationMessage)
-				}
-
-				return nil, fmt.Errorf("invalid value '%s' for option '%s', has to match the following regEx: %s", value, key, ideOption.ValidationPattern)
-			}
-		}
-
-		if len(ideOption.Enum) > 0 {
-			found := false
-			for _, e := range ideOption.Enum {
-				if value == e {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return nil, fmt.Errorf("invalid value '%s' for option '%s', has to match one of the following values: %v", value, key, ideOption.Enum)
-			}
+		err := ide.ValidateOption(key, ideOption, value)
+		if err != nil {
+			return nil, err
 		}
 
 		retMap[key] = config.OptionValue{
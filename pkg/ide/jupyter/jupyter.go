@@ -26,6 +26,7 @@ var Options = ide.Options{
 	OpenOption: {
 		Name:        OpenOption,
 		Description: "If DevPod should automatically open the browser",
+		Type:        ide.OptionTypeBool,
 		Default:     "true",
 		Enum: []string{
 			"true",
@@ -40,6 +40,7 @@ var Options = ide.Options{
 	ForwardPortsOption: {
 		Name:        ForwardPortsOption,
 		Description: "If DevPod should automatically do port-forwarding",
+		Type:        ide.OptionTypeBool,
 		Default:     "true",
 		Enum: []string{
 			"true",
@@ -59,6 +60,7 @@ var Options = ide.Options{
 	OpenOption: {
 		Name:        OpenOption,
 		Description: "If DevPod should automatically open the browser",
+		Type:        ide.OptionTypeBool,
 		Default:     "true",
 		Enum: []string{
 			"true",
@@ -0,0 +1,108 @@
+package ide
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/loft-sh/devpod/pkg/config"
+)
+
+// ValidateOptions checks all given values against the ide options and
+// returns an error listing every violation found
+func ValidateOptions(opts Options, values map[string]config.OptionValue) error {
+	keys := []string{}
+	for key := range opts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	errs := []error{}
+	for _, key := range keys {
+		value := values[key].Value
+		if value == "" {
+			continue
+		}
+
+		err := ValidateOption(key, opts[key], value)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateOption checks a single value against the validation pattern
+// and either the enum or, if there is none, the type of the ide option
+func ValidateOption(key string, option Option, value string) error {
+	if option.ValidationPattern != "" {
+		matcher, err := regexp.Compile(option.ValidationPattern)
+		if err != nil {
+			return err
+		}
+
+		if !matcher.MatchString(value) {
+			if option.ValidationMessage != "" {
+				return fmt.Errorf(option.ValidationMessage)
+			}
+
+			return fmt.Errorf("invalid value '%s' for option '%s', has to match the following regEx: %s", value, key, option.ValidationPattern)
+		}
+	}
+
+	if len(option.Enum) > 0 {
+		found := false
+		for _, e := range option.Enum {
+			if value == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid value '%s' for option '%s', has to match one of the following values: %v", value, key, option.Enum)
+		}
+
+		// the enum is stricter than any type
+		return nil
+	}
+
+	return validateType(key, option.Type, value)
+}
+
+func validateType(key string, optionType OptionType, value string) error {
+	switch optionType {
+	case "", OptionTypeString:
+		// options without a type are strings, so any value is valid
+	case OptionTypeBool:
+		_, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' for option '%s', must be a boolean", value, key)
+		}
+	case OptionTypeInt:
+		_, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' for option '%s', must be a number", value, key)
+		}
+	case OptionTypePort:
+		port, err := strconv.Atoi(value)
+		if err != nil || port < 1 || port > 65535 {
+			return fmt.Errorf("invalid value '%s' for option '%s', must be a port between 1 and 65535", value, key)
+		}
+	case OptionTypePath:
+		if !filepath.IsAbs(value) {
+			return fmt.Errorf("invalid value '%s' for option '%s', must be an absolute path", value, key)
+		}
+	case OptionTypeDuration:
+		_, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' for option '%s', must be a duration like 10s, 5m or 24h", value, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package ide
+
+import (
+	"testing"
+
+	"github.com/loft-sh/devpod/pkg/config"
+	"gotest.tools/assert"
+)
+
+func TestValidateOptions(t *testing.T) {
+	opts := Options{
+		"OPEN": {
+			Name: "OPEN",
+			Enum: []string{"true", "false"},
+		},
+		"VERSION": {
+			Name:              "VERSION",
+			ValidationPattern: "^v[0-9]+$",
+		},
+		"FREEFORM": {
+			Name: "FREEFORM",
+		},
+	}
+
+	err := ValidateOptions(opts, map[string]config.OptionValue{
+		"OPEN":     {Value: "true"},
+		"VERSION":  {Value: "v1"},
+		"FREEFORM": {Value: "anything"},
+	})
+	assert.NilError(t, err)
+
+	err = ValidateOptions(opts, map[string]config.OptionValue{
+		"OPEN":    {Value: "maybe"},
+		"VERSION": {Value: "latest"},
+	})
+	assert.ErrorContains(t, err, "invalid value 'maybe' for option 'OPEN'")
+	assert.ErrorContains(t, err, "invalid value 'latest' for option 'VERSION'")
+}
+
+func TestValidateOptionsType(t *testing.T) {
+	opts := Options{
+		"OPEN":    {Name: "OPEN", Type: OptionTypeBool},
+		"JOBS":    {Name: "JOBS", Type: OptionTypeInt},
+		"PORT":    {Name: "PORT", Type: OptionTypePort},
+		"DIR":     {Name: "DIR", Type: OptionTypePath},
+		"TIMEOUT": {Name: "TIMEOUT", Type: OptionTypeDuration},
+	}
+
+	err := ValidateOptions(opts, map[string]config.OptionValue{
+		"OPEN":    {Value: "false"},
+		"JOBS":    {Value: "4"},
+		"PORT":    {Value: "8080"},
+		"DIR":     {Value: "/home/devpod"},
+		"TIMEOUT": {Value: "30s"},
+	})
+	assert.NilError(t, err)
+
+	err = ValidateOptions(opts, map[string]config.OptionValue{
+		"OPEN":    {Value: "yes please"},
+		"JOBS":    {Value: "four"},
+		"PORT":    {Value: "70000"},
+		"DIR":     {Value: "relative/dir"},
+		"TIMEOUT": {Value: "30"},
+	})
+	assert.ErrorContains(t, err, "must be a boolean")
+	assert.ErrorContains(t, err, "must be a number")
+	assert.ErrorContains(t, err, "must be a port between 1 and 65535")
+	assert.ErrorContains(t, err, "must be an absolute path")
+	assert.ErrorContains(t, err, "must be a duration")
+}
+
+func TestValidateOptionEnumBeforeType(t *testing.T) {
+	option := Option{
+		Name: "OPEN",
+		Type: OptionTypeBool,
+		Enum: []string{"true", "false"},
+	}
+
+	for _, value := range []string{"yes", "1"} {
+		err := ValidateOption("OPEN", option, value)
+		assert.ErrorContains(t, err, "has to match one of the following values: [true false]")
+	}
+	assert.NilError(t, ValidateOption("OPEN", option, "false"))
+}
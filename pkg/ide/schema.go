@@ -0,0 +1,78 @@
+package ide
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+type schema struct {
+	Schema               string                    `json:"$schema"`
+	Type                 string                    `json:"type"`
+	Properties           map[string]schemaProperty `json:"properties"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties bool                      `json:"additionalProperties"`
+}
+
+type schemaProperty struct {
+	Type        string      `json:"type"`
+	Description string      `json:"description,omitempty"`
+	Default     interface{} `json:"default,omitempty"`
+	Enum        []string    `json:"enum,omitempty"`
+	Pattern     string      `json:"pattern,omitempty"`
+	Minimum     *int        `json:"minimum,omitempty"`
+	Maximum     *int        `json:"maximum,omitempty"`
+}
+
+// SchemaJSON returns the options as a JSON schema object, so that
+// option forms can be generated from it
+func (o Options) SchemaJSON() ([]byte, error) {
+	retSchema := schema{
+		Schema:     "http://json-schema.org/draft-07/schema#",
+		Type:       "object",
+		Properties: map[string]schemaProperty{},
+	}
+	for key, option := range o {
+		retSchema.Properties[key] = optionToSchemaProperty(option)
+		if option.Required {
+			retSchema.Required = append(retSchema.Required, key)
+		}
+	}
+	sort.Strings(retSchema.Required)
+
+	return json.Marshal(retSchema)
+}
+
+func optionToSchemaProperty(option Option) schemaProperty {
+	property := schemaProperty{
+		Type:        "string",
+		Description: option.Description,
+	}
+	if option.Default != "" {
+		property.Default = option.Default
+	}
+
+	switch option.Type {
+	case "", OptionTypeString, OptionTypePath, OptionTypeDuration:
+		// options without a type are strings
+		property.Enum = option.Enum
+		property.Pattern = option.ValidationPattern
+	case OptionTypeBool:
+		property.Type = "boolean"
+		if parsed, err := strconv.ParseBool(option.Default); err == nil {
+			property.Default = parsed
+		}
+	case OptionTypeInt, OptionTypePort:
+		property.Type = "integer"
+		if parsed, err := strconv.Atoi(option.Default); err == nil {
+			property.Default = parsed
+		}
+		if option.Type == OptionTypePort {
+			minimum, maximum := 1, 65535
+			property.Minimum = &minimum
+			property.Maximum = &maximum
+		}
+	}
+
+	return property
+}
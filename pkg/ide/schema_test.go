@@ -0,0 +1,20 @@
+package ide
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestOptionsSchemaJSON(t *testing.T) {
+	opts := Options{
+		"OPEN":    {Name: "OPEN", Description: "Open the browser", Type: OptionTypeBool, Default: "true", Enum: []string{"true", "false"}},
+		"PORT":    {Name: "PORT", Type: OptionTypePort, Default: "8080", ValidationPattern: "^[0-9]+$"},
+		"BIND":    {Name: "BIND", ValidationPattern: "^[0-9.]+:[0-9]+$"},
+		"VERSION": {Name: "VERSION", Enum: []string{"stable", "insiders"}, Required: true},
+	}
+
+	out, err := opts.SchemaJSON()
+	assert.NilError(t, err)
+	assert.Equal(t, string(out), `{"$schema":"http://json-schema.org/draft-07/schema#","type":"object","properties":{"BIND":{"type":"string","pattern":"^[0-9.]+:[0-9]+$"},"OPEN":{"type":"boolean","description":"Open the browser","default":true},"PORT":{"type":"integer","default":8080,"minimum":1,"maximum":65535},"VERSION":{"type":"string","enum":["stable","insiders"]}},"required":["VERSION"],"additionalProperties":false}`)
+}
@@ -1,6 +1,13 @@
 package ide
 
-import "github.com/loft-sh/devpod/pkg/config"
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/loft-sh/devpod/pkg/config"
+)
 
 type IDE interface {
 	Install() error
@@ -8,6 +15,17 @@ type IDE interface {
 
 type Options map[string]Option
 
+type OptionType string
+
+const (
+	OptionTypeString   OptionType = "string"
+	OptionTypeBool     OptionType = "boolean"
+	OptionTypeInt      OptionType = "number"
+	OptionTypePort     OptionType = "port"
+	OptionTypePath     OptionType = "path"
+	OptionTypeDuration OptionType = "duration"
+)
+
 type Option struct {
 	// Name is the name of the IDE option
 	Name string `json:"name,omitempty"`
@@ -15,6 +33,12 @@ type Option struct {
 	// Description is the description of the IDE option
 	Description string `json:"description,omitempty"`
 
+	// Type is the type of the option. Can be one of: string, boolean, number, port, path or duration. Defaults to string
+	Type OptionType `json:"type,omitempty"`
+
+	// Required specifies if the option needs a value
+	Required bool `json:"required,omitempty"`
+
 	// Default is the default value for this option
 	Default string `json:"default,omitempty"`
 
@@ -37,3 +61,81 @@ func (o Options) GetValue(values map[string]config.OptionValue, key string) stri
 
 	return ""
 }
+
+func (o Options) GetBoolValue(values map[string]config.OptionValue, key string) (bool, error) {
+	value := o.GetValue(values, key)
+	if value == "" {
+		return false, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value '%s' for option '%s', must be a boolean", value, key)
+	}
+
+	return parsed, nil
+}
+
+func (o Options) GetIntValue(values map[string]config.OptionValue, key string) (int, error) {
+	value := o.GetValue(values, key)
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' for option '%s', must be a number", value, key)
+	}
+
+	return parsed, nil
+}
+
+func (o Options) GetDurationValue(values map[string]config.OptionValue, key string) (time.Duration, error) {
+	value := o.GetValue(values, key)
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' for option '%s', must be a duration like 10s, 5m or 24h", value, key)
+	}
+
+	return parsed, nil
+}
+
+// Merge combines the option defaults with the given user values. It returns the
+// merged values, the keys of user values that are not known options and an
+// error if a required option is missing or a value is invalid.
+func (o Options) Merge(userValues map[string]config.OptionValue) (map[string]config.OptionValue, []string, error) {
+	unknownKeys := []string{}
+	for key := range userValues {
+		if _, ok := o[key]; !ok {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	sort.Strings(unknownKeys)
+
+	missingKeys := []string{}
+	merged := map[string]config.OptionValue{}
+	for key, option := range o {
+		if userValues[key].Value != "" {
+			merged[key] = userValues[key]
+		} else if option.Default != "" {
+			merged[key] = config.OptionValue{Value: option.Default}
+		} else if option.Required {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+	if len(missingKeys) > 0 {
+		sort.Strings(missingKeys)
+		return nil, unknownKeys, fmt.Errorf("missing value for required options: %v", missingKeys)
+	}
+
+	err := ValidateOptions(o, merged)
+	if err != nil {
+		return nil, unknownKeys, err
+	}
+
+	return merged, unknownKeys, nil
+}
@@ -0,0 +1,87 @@
+package ide
+
+import (
+	"testing"
+	"time"
+
+	"github.com/loft-sh/devpod/pkg/config"
+	"gotest.tools/assert"
+)
+
+func TestOptionsMerge(t *testing.T) {
+	opts := Options{
+		"OPEN":    {Name: "OPEN", Type: OptionTypeBool, Default: "true"},
+		"VERSION": {Name: "VERSION", Default: "latest"},
+		"TOKEN":   {Name: "TOKEN", Required: true},
+	}
+
+	merged, unknown, err := opts.Merge(map[string]config.OptionValue{
+		"VERSION": {Value: "v1.0.0", UserProvided: true},
+		"TOKEN":   {Value: "secret", UserProvided: true},
+		"UNKNOWN": {Value: "value", UserProvided: true},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unknown, []string{"UNKNOWN"})
+	assert.DeepEqual(t, merged, map[string]config.OptionValue{
+		"OPEN":    {Value: "true"},
+		"VERSION": {Value: "v1.0.0", UserProvided: true},
+		"TOKEN":   {Value: "secret", UserProvided: true},
+	})
+
+	_, _, err = opts.Merge(map[string]config.OptionValue{})
+	assert.ErrorContains(t, err, "missing value for required options: [TOKEN]")
+
+	_, _, err = opts.Merge(map[string]config.OptionValue{
+		"OPEN":  {Value: "maybe"},
+		"TOKEN": {Value: "secret"},
+	})
+	assert.ErrorContains(t, err, "must be a boolean")
+}
+
+func TestOptionsTypedValues(t *testing.T) {
+	opts := Options{
+		"OPEN":    {Name: "OPEN", Type: OptionTypeBool, Default: "true"},
+		"JOBS":    {Name: "JOBS", Type: OptionTypeInt, Default: "2"},
+		"TIMEOUT": {Name: "TIMEOUT", Type: OptionTypeDuration},
+	}
+
+	// defaults and empty values
+	open, err := opts.GetBoolValue(nil, "OPEN")
+	assert.NilError(t, err)
+	assert.Equal(t, open, true)
+	jobs, err := opts.GetIntValue(nil, "JOBS")
+	assert.NilError(t, err)
+	assert.Equal(t, jobs, 2)
+	timeout, err := opts.GetDurationValue(nil, "TIMEOUT")
+	assert.NilError(t, err)
+	assert.Equal(t, timeout, time.Duration(0))
+
+	// user values
+	values := map[string]config.OptionValue{
+		"OPEN":    {Value: "false"},
+		"JOBS":    {Value: "8"},
+		"TIMEOUT": {Value: "1m30s"},
+	}
+	open, err = opts.GetBoolValue(values, "OPEN")
+	assert.NilError(t, err)
+	assert.Equal(t, open, false)
+	jobs, err = opts.GetIntValue(values, "JOBS")
+	assert.NilError(t, err)
+	assert.Equal(t, jobs, 8)
+	timeout, err = opts.GetDurationValue(values, "TIMEOUT")
+	assert.NilError(t, err)
+	assert.Equal(t, timeout, 90*time.Second)
+
+	// invalid values
+	values = map[string]config.OptionValue{
+		"OPEN":    {Value: "maybe"},
+		"JOBS":    {Value: "eight"},
+		"TIMEOUT": {Value: "90"},
+	}
+	_, err = opts.GetBoolValue(values, "OPEN")
+	assert.ErrorContains(t, err, "must be a boolean")
+	_, err = opts.GetIntValue(values, "JOBS")
+	assert.ErrorContains(t, err, "must be a number")
+	_, err = opts.GetDurationValue(values, "TIMEOUT")
+	assert.ErrorContains(t, err, "must be a duration")
+}
@@ -26,6 +26,7 @@ var Options = ide.Options{
 	OpenNewWindow: {
 		Name:        OpenNewWindow,
 		Description: "If true, DevPod will open the project in a new vscode window",
+		Type:        ide.OptionTypeBool,
 		Default:     "true",
 		Enum: []string{
 			"false",
@@ -34,7 +34,7 @@ func tryOpen(ctx context.Context, url string, log log.Logger) error {
 		return err
 	}
 
-	resp, err := devpodhttp.GetHTTPClient().Do(req)
+	resp, err := devpodhttp.GetHTTPClientNoRetry().Do(req)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofrs/flock"
@@ -14,8 +15,13 @@ import (
 
 type CreateCommand func() (*exec.Cmd, error)
 
+// PidFile returns the path of the pid file Single writes for the given file
+func PidFile(file string) string {
+	return filepath.Join(os.TempDir(), file)
+}
+
 func Single(file string, createCommand CreateCommand) error {
-	file = filepath.Join(os.TempDir(), file)
+	file = PidFile(file)
 	fileLock := flock.New(file + ".lock")
 	locked, err := fileLock.TryLock()
 	if err != nil {
@@ -34,13 +40,18 @@ func Single(file string, createCommand CreateCommand) error {
 			return err
 		}
 	} else {
-		// check if process id exists
-		isRunning, err := command.IsRunning(string(pid))
-		if err != nil {
-			return err
-		} else if isRunning {
+		// check if process id exists, a pid file we cannot parse
+		// is treated the same as one of a dead process
+		isRunning, err := command.IsRunning(strings.TrimSpace(string(pid)))
+		if err == nil && isRunning {
 			return nil
 		}
+
+		// remove the stale pid file
+		err = os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "remove stale pid file")
+		}
 	}
 
 	// create command
@@ -0,0 +1,102 @@
+//go:build linux || darwin || unix
+
+package single
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/gofrs/flock"
+	perrors "github.com/pkg/errors"
+)
+
+// SingleSocket works like Single, but instead of a pid file it uses a unix
+// domain socket as the lock. The listening socket is passed to the started
+// process as an extra file, so the process has to keep that file descriptor
+// open. As soon as the process exits, the kernel closes the socket and the
+// next call will start the command again.
+func SingleSocket(name string, createCommand CreateCommand) error {
+	socketPath := filepath.Join(os.TempDir(), name+".sock")
+	fileLock := flock.New(socketPath + ".lock")
+	locked, err := fileLock.TryLock()
+	if err != nil {
+		return perrors.Wrap(err, "acquire lock")
+	} else if !locked {
+		return nil
+	}
+	defer func(fileLock *flock.Flock) {
+		_ = fileLock.Unlock()
+	}(fileLock)
+
+	// check if the socket is still held by a process
+	if isSocketAlive(socketPath) {
+		return nil
+	}
+
+	// remove the stale socket file
+	err = os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return perrors.Wrap(err, "remove stale socket")
+	}
+
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: socketPath, Net: "unix"})
+	if err != nil {
+		return perrors.Wrap(err, "listen on socket")
+	}
+	defer listener.Close()
+
+	// the socket file needs to stay around after we close our copy
+	listener.SetUnlinkOnClose(false)
+	socketFile, err := listener.File()
+	if err != nil {
+		return err
+	}
+	defer socketFile.Close()
+
+	// create command
+	cmd, err := createCommand()
+	if err != nil {
+		_ = os.Remove(socketPath)
+		return err
+	}
+	cmd.ExtraFiles = append(cmd.ExtraFiles, socketFile)
+
+	// pipe streams into file.streams
+	f, err := os.Create(socketPath + ".streams")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if cmd.Stderr == nil {
+		cmd.Stderr = f
+	}
+	if cmd.Stdout == nil {
+		cmd.Stdout = f
+	}
+
+	// start process
+	err = cmd.Start()
+	if err != nil {
+		_ = os.Remove(socketPath)
+		return err
+	}
+
+	// release process resources
+	return cmd.Process.Release()
+}
+
+func isSocketAlive(socketPath string) bool {
+	conn, err := net.DialTimeout("unix", socketPath, time.Second)
+	if err == nil {
+		_ = conn.Close()
+		return true
+	}
+
+	// a missing socket or one nobody listens on anymore is stale, any other
+	// error (e.g. a full accept queue) means the holder is still alive
+	return !errors.Is(err, syscall.ENOENT) && !errors.Is(err, syscall.ECONNREFUSED)
+}
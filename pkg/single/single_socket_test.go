@@ -0,0 +1,67 @@
+//go:build linux || darwin || unix
+
+package single
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func TestSingleSocket(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	pidFile := filepath.Join(os.TempDir(), "sleep.pid")
+
+	starts := 0
+	createCommand := func() (*exec.Cmd, error) {
+		starts++
+		return exec.Command("sh", "-c", "echo $$ > '"+pidFile+"' && exec sleep 30"), nil
+	}
+
+	// first call starts the process
+	assert.NilError(t, SingleSocket("test", createCommand))
+	assert.Equal(t, starts, 1)
+	pid := waitForPid(t, pidFile)
+	defer func() {
+		_ = syscall.Kill(pid, syscall.SIGKILL)
+	}()
+
+	// second call sees the socket is held
+	assert.NilError(t, SingleSocket("test", createCommand))
+	assert.Equal(t, starts, 1)
+
+	// after the process is gone, the socket is stale and the process is started again
+	assert.NilError(t, syscall.Kill(pid, syscall.SIGKILL))
+	var status syscall.WaitStatus
+	_, err := syscall.Wait4(pid, &status, 0, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, os.Remove(pidFile))
+
+	assert.NilError(t, SingleSocket("test", createCommand))
+	assert.Equal(t, starts, 2)
+	newPid := waitForPid(t, pidFile)
+	_ = syscall.Kill(newPid, syscall.SIGKILL)
+}
+
+func waitForPid(t *testing.T, pidFile string) int {
+	for i := 0; i < 100; i++ {
+		out, err := os.ReadFile(pidFile)
+		if err == nil && strings.HasSuffix(string(out), "\n") {
+			pid, err := strconv.Atoi(strings.TrimSpace(string(out)))
+			assert.NilError(t, err)
+			return pid
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %s", pidFile)
+	return 0
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package single
+
+import "fmt"
+
+func SingleSocket(name string, createCommand CreateCommand) error {
+	return fmt.Errorf("unix socket locks are not supported on windows, please use Single instead")
+}
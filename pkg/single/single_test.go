@@ -0,0 +1,68 @@
+package single
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestSingleStalePidFile(t *testing.T) {
+	testCases := map[string]string{
+		"invalid pid": "not-a-pid",
+		"empty file":  "",
+	}
+	for name, content := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TMPDIR", t.TempDir())
+			pidFile := filepath.Join(os.TempDir(), "test.pid")
+			assert.NilError(t, os.WriteFile(pidFile, []byte(content), 0666))
+
+			started := false
+			err := Single("test.pid", func() (*exec.Cmd, error) {
+				started = true
+				return exec.Command("true"), nil
+			})
+			assert.NilError(t, err)
+			assert.Assert(t, started, "expected command to be started")
+
+			newPid, err := os.ReadFile(pidFile)
+			assert.NilError(t, err)
+			assert.Assert(t, string(newPid) != content)
+		})
+	}
+}
+
+func TestSingleRemovesStalePidFile(t *testing.T) {
+	// get the pid of a process that is guaranteed to be gone
+	deadCmd := exec.Command("true")
+	assert.NilError(t, deadCmd.Run())
+
+	t.Setenv("TMPDIR", t.TempDir())
+	pidFile := filepath.Join(os.TempDir(), "test.pid")
+	assert.NilError(t, os.WriteFile(pidFile, []byte(strconv.Itoa(deadCmd.Process.Pid)), 0666))
+
+	err := Single("test.pid", func() (*exec.Cmd, error) {
+		return nil, errors.New("create command")
+	})
+	assert.ErrorContains(t, err, "create command")
+
+	_, err = os.Stat(pidFile)
+	assert.Assert(t, os.IsNotExist(err), "expected stale pid file to be removed")
+}
+
+func TestPidFile(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	err := Single("test.pid", func() (*exec.Cmd, error) {
+		return exec.Command("true"), nil
+	})
+	assert.NilError(t, err)
+
+	_, err = os.Stat(PidFile("test.pid"))
+	assert.NilError(t, err)
+}